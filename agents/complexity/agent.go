@@ -0,0 +1,188 @@
+// Package complexity computes per-function cyclomatic complexity,
+// cognitive complexity, nesting depth, parameter count, and return count
+// for Go source files, and reports violations of configurable thresholds
+// as findings.
+package complexity
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "complexity"
+
+// Agent analyzes Go source files against a Config of rule thresholds.
+type Agent struct {
+	cfg Config
+}
+
+// New returns an Agent that checks functions against cfg.
+func New(cfg Config) *Agent {
+	return &Agent{cfg: cfg}
+}
+
+// metrics holds the measurements taken for a single function.
+type metrics struct {
+	name       string
+	cyclomatic int
+	cognitive  int
+	maxNesting int
+	params     int
+	returns    int
+}
+
+// metricValue looks up the measured value for a rule key.
+func (m metrics) metricValue(rule string) (int, bool) {
+	switch rule {
+	case RuleMaxCyclomatic:
+		return m.cyclomatic, true
+	case RuleMaxCognitive:
+		return m.cognitive, true
+	case RuleMaxNesting:
+		return m.maxNesting, true
+	case RuleMaxParams:
+		return m.params, true
+	case RuleMaxReturns:
+		return m.returns, true
+	default:
+		return 0, false
+	}
+}
+
+// AnalyzeFile parses the Go source file at path and returns a Finding for
+// every function that exceeds a configured threshold.
+func (a *Agent) AnalyzeFile(path string) ([]finding.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("complexity: parse %s: %w", path, err)
+	}
+	return a.analyzeFile(fset, file, path), nil
+}
+
+func (a *Agent) analyzeFile(fset *token.FileSet, file *ast.File, path string) []finding.Finding {
+	var findings []finding.Finding
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		m := measure(fn)
+		findings = append(findings, a.evaluate(m, fset, fn, path)...)
+	}
+	return findings
+}
+
+// evaluate compares m against every configured rule and returns one
+// Finding per violated rule.
+func (a *Agent) evaluate(m metrics, fset *token.FileSet, fn *ast.FuncDecl, path string) []finding.Finding {
+	var findings []finding.Finding
+	pos := fset.Position(fn.Name.Pos())
+
+	for rule, rc := range a.cfg.Rules {
+		value, ok := m.metricValue(rule)
+		if !ok || value <= rc.Threshold {
+			continue
+		}
+		findings = append(findings, finding.Finding{
+			Agent:    agentName,
+			Rule:     rule,
+			Severity: rc.Severity,
+			Message:  fmt.Sprintf("%s: %s is %d, exceeds %d allowed", m.name, rule, value, rc.Threshold),
+			Position: finding.Position{File: path, Line: pos.Line, Column: pos.Column},
+			Function: m.name,
+			Metric:   &finding.Metric{Name: rule, Value: value, Limit: rc.Threshold},
+		})
+	}
+	return findings
+}
+
+// measure walks fn and computes its complexity metrics.
+func measure(fn *ast.FuncDecl) metrics {
+	m := metrics{name: fn.Name.Name, cyclomatic: 1, params: countParams(fn.Type)}
+
+	depth := 0
+	var nestingStack []bool
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if n == nil {
+			// ast.Inspect calls f(nil) once the children of the node that
+			// pushed the matching stack entry have all been visited, so
+			// the stack unwinds in the same order it was built.
+			if len(nestingStack) > 0 {
+				last := len(nestingStack) - 1
+				if nestingStack[last] {
+					depth--
+				}
+				nestingStack = nestingStack[:last]
+			}
+			return true
+		}
+
+		nests := false
+		switch expr := n.(type) {
+		case *ast.IfStmt:
+			m.cyclomatic++
+			m.cognitive += 1 + depth
+			nests = true
+		case *ast.ForStmt:
+			m.cyclomatic++
+			m.cognitive += 1 + depth
+			nests = true
+		case *ast.RangeStmt:
+			m.cyclomatic++
+			m.cognitive += 1 + depth
+			nests = true
+		case *ast.SwitchStmt:
+			nests = true
+		case *ast.TypeSwitchStmt:
+			nests = true
+		case *ast.SelectStmt:
+			nests = true
+		case *ast.FuncLit:
+			nests = true
+		case *ast.CaseClause:
+			m.cyclomatic++
+			m.cognitive++
+		case *ast.CommClause:
+			m.cyclomatic++
+			m.cognitive++
+		case *ast.BinaryExpr:
+			if expr.Op == token.LAND || expr.Op == token.LOR {
+				m.cyclomatic++
+			}
+		case *ast.ReturnStmt:
+			m.returns++
+		}
+
+		nestingStack = append(nestingStack, nests)
+		if nests {
+			depth++
+			if depth > m.maxNesting {
+				m.maxNesting = depth
+			}
+		}
+		return true
+	})
+
+	return m
+}
+
+func countParams(t *ast.FuncType) int {
+	if t.Params == nil {
+		return 0
+	}
+	n := 0
+	for _, field := range t.Params.List {
+		if len(field.Names) == 0 {
+			n++
+			continue
+		}
+		n += len(field.Names)
+	}
+	return n
+}
@@ -0,0 +1,57 @@
+package complexity
+
+import (
+	"testing"
+)
+
+func TestAnalyzeFileFlagsDeepNesting(t *testing.T) {
+	cfg, err := DefaultConfig()
+	if err != nil {
+		t.Fatalf("DefaultConfig() error = %v", err)
+	}
+
+	a := New(cfg)
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var gotNesting, gotParams bool
+	for _, f := range findings {
+		if f.Function == "ProcessUsers" && f.Rule == RuleMaxNesting {
+			gotNesting = true
+		}
+		if f.Function == "CreateComplexUserReportWithManyParametersAndLongName" && f.Rule == RuleMaxParams {
+			gotParams = true
+		}
+	}
+
+	if !gotNesting {
+		t.Errorf("expected a %s finding for ProcessUsers, got %+v", RuleMaxNesting, findings)
+	}
+	if !gotParams {
+		t.Errorf("expected a %s finding for CreateComplexUserReportWithManyParametersAndLongName, got %+v", RuleMaxParams, findings)
+	}
+}
+
+func TestMeasureCountsReturns(t *testing.T) {
+	cfg := Config{Rules: map[string]RuleConfig{
+		RuleMaxReturns: {Threshold: 0, Severity: "minor"},
+	}}
+	a := New(cfg)
+
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	for _, f := range findings {
+		if f.Function == "ProcessUsers" && f.Rule == RuleMaxReturns {
+			if f.Metric == nil || f.Metric.Value < 2 {
+				t.Errorf("ProcessUsers max_returns metric = %+v, want value >= 2", f.Metric)
+			}
+			return
+		}
+	}
+	t.Errorf("expected a %s finding for ProcessUsers", RuleMaxReturns)
+}
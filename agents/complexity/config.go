@@ -0,0 +1,59 @@
+package complexity
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+//go:embed rules.yaml
+var defaultRulesYAML []byte
+
+// Rule keys recognized in a Config's Rules map. Each corresponds to one of
+// the metrics computed per function.
+const (
+	RuleMaxCyclomatic = "max_cyclomatic"
+	RuleMaxCognitive  = "max_cognitive"
+	RuleMaxNesting    = "max_nesting"
+	RuleMaxParams     = "max_params"
+	RuleMaxReturns    = "max_returns"
+)
+
+// RuleConfig is the threshold and severity for a single rule.
+type RuleConfig struct {
+	Threshold int              `yaml:"threshold"`
+	Severity  finding.Severity `yaml:"severity"`
+}
+
+// Config holds the per-rule thresholds that drive the complexity agent.
+// A rule absent from Rules is not checked.
+type Config struct {
+	Rules map[string]RuleConfig `yaml:"rules"`
+}
+
+// DefaultConfig returns the thresholds shipped in rules.yaml.
+func DefaultConfig() (Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(defaultRulesYAML, &cfg); err != nil {
+		return Config{}, fmt.Errorf("complexity: parse default rules.yaml: %w", err)
+	}
+	return cfg, nil
+}
+
+// LoadConfig reads a Config from a YAML file, for callers that want to
+// override the default thresholds.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("complexity: read config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("complexity: parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,199 @@
+// Package di analyzes Go source for dependency-injection and testability
+// anti-patterns: package-level mutable globals and direct calls to
+// hard-to-fake symbols (HTTP clients, file I/O, the wall clock,
+// randomness) that would be better accepted as parameters or interfaces.
+package di
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "di"
+
+// Agent analyzes Go source files for testability anti-patterns.
+type Agent struct {
+	cfg Config
+}
+
+// New returns an Agent that watches for cfg.Dependencies and package-level
+// mutable globals.
+func New(cfg Config) *Agent {
+	return &Agent{cfg: cfg}
+}
+
+// AnalyzeFile parses the Go source file at path and returns a Finding for
+// every testability anti-pattern found.
+func (a *Agent) AnalyzeFile(path string) ([]finding.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("di: parse %s: %w", path, err)
+	}
+
+	var findings []finding.Finding
+	findings = append(findings, a.findGlobals(fset, file, path)...)
+	findings = append(findings, a.findHardToTestCalls(fset, file, path)...)
+	return findings, nil
+}
+
+// findGlobals flags package-level `var` declarations, excluding sentinel
+// values conventionally created with errors.New or fmt.Errorf, which are
+// immutable in practice despite being declared with var.
+func (a *Agent) findGlobals(fset *token.FileSet, file *ast.File, path string) []finding.Finding {
+	var findings []finding.Finding
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || isSentinelValue(valueSpec) {
+				continue
+			}
+			for _, name := range valueSpec.Names {
+				if name.Name == "_" {
+					continue
+				}
+				pos := fset.Position(name.Pos())
+				findings = append(findings, finding.Finding{
+					Agent:      agentName,
+					Rule:       "global-mutable-state",
+					Severity:   finding.SeverityMajor,
+					Message:    fmt.Sprintf("package-level variable %q is mutable shared state", name.Name),
+					Position:   finding.Position{File: path, Line: pos.Line, Column: pos.Column},
+					Suggestion: "thread this value through a constructor parameter or a struct field instead of a package-level var",
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// isSentinelValue reports whether spec looks like the conventional
+// `var ErrX = errors.New(...)` / `var ErrX = fmt.Errorf(...)` sentinel
+// pattern, which isn't the kind of mutable state this agent targets.
+func isSentinelValue(spec *ast.ValueSpec) bool {
+	if len(spec.Values) != 1 {
+		return false
+	}
+	call, ok := spec.Values[0].(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	switch sel := selectorName(call.Fun); sel {
+	case "errors.New", "fmt.Errorf":
+		return true
+	default:
+		return false
+	}
+}
+
+// findHardToTestCalls flags calls to configured hard-to-test symbols made
+// from a function that has no parameter suggesting the dependency was
+// already injected.
+func (a *Agent) findHardToTestCalls(fset *token.FileSet, file *ast.File, path string) []finding.Finding {
+	var findings []finding.Finding
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name := selectorName(call.Fun)
+			dep, ok := a.matchDependency(name)
+			if !ok {
+				// Calls like http.DefaultClient.Get(url) have a selector
+				// chain two levels deep: the dependency pattern matches the
+				// receiver (http.DefaultClient), not the method (Get).
+				if sel, ok2 := call.Fun.(*ast.SelectorExpr); ok2 {
+					dep, ok = a.matchDependency(selectorName(sel.X))
+				}
+			}
+			if !ok || hasInjectedParam(fn, dep.ParamHint) {
+				return true
+			}
+
+			pos := fset.Position(call.Pos())
+			findings = append(findings, finding.Finding{
+				Agent:      agentName,
+				Rule:       "untestable-dependency",
+				Severity:   finding.SeverityMinor,
+				Message:    fmt.Sprintf("%s calls %s directly, making %s hard to test in isolation", fn.Name.Name, dep.Pattern, fn.Name.Name),
+				Position:   finding.Position{File: path, Line: pos.Line, Column: pos.Column},
+				Function:   fn.Name.Name,
+				Suggestion: dep.Suggestion,
+			})
+			return true
+		})
+	}
+	return findings
+}
+
+func (a *Agent) matchDependency(selector string) (Dependency, bool) {
+	for _, dep := range a.cfg.Dependencies {
+		if dep.Pattern == selector {
+			return dep, true
+		}
+	}
+	return Dependency{}, false
+}
+
+// hasInjectedParam reports whether fn already has a parameter whose type
+// looks like it could supply the dependency matching hint: a named type
+// (plain, pointer, or package-qualified) whose name contains hint,
+// case-insensitively, or, for the "Clock" hint, any func-typed parameter.
+func hasInjectedParam(fn *ast.FuncDecl, hint string) bool {
+	if fn.Type.Params == nil {
+		return false
+	}
+	for _, field := range fn.Type.Params.List {
+		switch t := field.Type.(type) {
+		case *ast.FuncType:
+			if hint == "Clock" {
+				return true
+			}
+		case *ast.StarExpr:
+			if ident, ok := t.X.(*ast.Ident); ok && strings.Contains(strings.ToLower(ident.Name), strings.ToLower(hint)) {
+				return true
+			}
+		case *ast.Ident:
+			if strings.Contains(strings.ToLower(t.Name), strings.ToLower(hint)) {
+				return true
+			}
+		case *ast.SelectorExpr:
+			if strings.Contains(strings.ToLower(t.Sel.Name), strings.ToLower(hint)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectorName renders a CallExpr.Fun or ValueSpec value's function
+// expression as "pkg.Symbol", or "" if it isn't a package-qualified
+// identifier.
+func selectorName(expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return pkg.Name + "." + sel.Sel.Name
+}
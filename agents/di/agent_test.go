@@ -0,0 +1,83 @@
+package di
+
+import "testing"
+
+func TestAnalyzeFileFlagsGlobalsAndHardToTestCalls(t *testing.T) {
+	a := New(DefaultConfig())
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var gotGlobal, gotHTTPGet, gotWriteFile bool
+	for _, f := range findings {
+		switch {
+		case f.Rule == "global-mutable-state" && f.Message == `package-level variable "globalCounter" is mutable shared state`:
+			gotGlobal = true
+		case f.Rule == "untestable-dependency" && f.Function == "FetchUserFromAPI":
+			gotHTTPGet = true
+		case f.Rule == "untestable-dependency" && f.Function == "CreateComplexUserReportWithManyParametersAndLongName":
+			gotWriteFile = true
+		}
+	}
+
+	if !gotGlobal {
+		t.Errorf("expected a global-mutable-state finding for globalCounter, got %+v", findings)
+	}
+	if !gotHTTPGet {
+		t.Errorf("expected an untestable-dependency finding for FetchUserFromAPI, got %+v", findings)
+	}
+	if !gotWriteFile {
+		t.Errorf("expected an untestable-dependency finding for the ioutil.WriteFile call, got %+v", findings)
+	}
+}
+
+func TestUnrelatedInterfaceParamDoesNotSuppressFinding(t *testing.T) {
+	a := New(DefaultConfig())
+	findings, err := a.AnalyzeFile("testdata/unrelated_interface_param.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var gotHTTPGet bool
+	for _, f := range findings {
+		if f.Rule == "untestable-dependency" && f.Function == "Foo" {
+			gotHTTPGet = true
+		}
+	}
+	if !gotHTTPGet {
+		t.Errorf("expected an untestable-dependency finding for Foo despite its unrelated interface param, got %+v", findings)
+	}
+}
+
+func TestDefaultClientCallIsFlagged(t *testing.T) {
+	a := New(DefaultConfig())
+	findings, err := a.AnalyzeFile("testdata/default_client.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var gotDefaultClient bool
+	for _, f := range findings {
+		if f.Rule == "untestable-dependency" && f.Function == "FetchViaDefaultClient" {
+			gotDefaultClient = true
+		}
+	}
+	if !gotDefaultClient {
+		t.Errorf("expected an untestable-dependency finding for http.DefaultClient.Get, got %+v", findings)
+	}
+}
+
+func TestSentinelErrorsAreNotFlaggedAsGlobalState(t *testing.T) {
+	a := New(DefaultConfig())
+	findings, err := a.AnalyzeFile("testdata/sentinel.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	for _, f := range findings {
+		if f.Rule == "global-mutable-state" {
+			t.Errorf("sentinel error wrongly flagged as global state: %+v", f)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package di
+
+// Dependency describes a hard-to-test symbol the agent watches for, and
+// how to suggest fixing it.
+type Dependency struct {
+	// Pattern is the "pkg.Symbol" selector this dependency matches, e.g.
+	// "http.Get".
+	Pattern string
+	// Suggestion is the refactor advice emitted when Pattern is called
+	// without an injected seam.
+	Suggestion string
+	// ParamHint is a substring (case-insensitive) looked for in the
+	// enclosing function's parameter types; a match means the dependency
+	// already appears to be injected, so no finding is emitted.
+	ParamHint string
+}
+
+// Config lists the dependencies the agent flags.
+type Config struct {
+	Dependencies []Dependency
+}
+
+// DefaultConfig returns the built-in set of hard-to-test symbols called
+// out in Go testability guides: HTTP clients, file and OS access, wall
+// clock reads, and non-deterministic randomness.
+func DefaultConfig() Config {
+	return Config{Dependencies: []Dependency{
+		{
+			Pattern:    "http.Get",
+			Suggestion: "accept an *http.Client parameter or inject an interface instead of calling http.Get directly",
+			ParamHint:  "Client",
+		},
+		{
+			Pattern:    "http.Post",
+			Suggestion: "accept an *http.Client parameter or inject an interface instead of calling http.Post directly",
+			ParamHint:  "Client",
+		},
+		{
+			Pattern:    "http.DefaultClient",
+			Suggestion: "accept an *http.Client parameter instead of depending on http.DefaultClient",
+			ParamHint:  "Client",
+		},
+		{
+			Pattern:    "os.Open",
+			Suggestion: "accept an fs.FS or io.Reader parameter instead of opening files by path",
+			ParamHint:  "FS",
+		},
+		{
+			Pattern:    "ioutil.WriteFile",
+			Suggestion: "extract an io.Writer parameter rather than writing to a file path",
+			ParamHint:  "Writer",
+		},
+		{
+			Pattern:    "os.WriteFile",
+			Suggestion: "extract an io.Writer parameter rather than writing to a file path",
+			ParamHint:  "Writer",
+		},
+		{
+			Pattern:    "time.Now",
+			Suggestion: "inject a func() time.Time clock instead of calling time.Now directly",
+			ParamHint:  "Clock",
+		},
+		{
+			Pattern:    "rand.Int",
+			Suggestion: "inject a rand.Source or a random-number interface instead of calling rand.Int directly",
+			ParamHint:  "Rand",
+		},
+	}}
+}
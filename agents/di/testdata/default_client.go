@@ -0,0 +1,9 @@
+package testdata
+
+import "net/http"
+
+// FetchViaDefaultClient calls through http.DefaultClient, which the di
+// agent should flag the same way it flags http.Get.
+func FetchViaDefaultClient(url string) (*http.Response, error) {
+	return http.DefaultClient.Get(url)
+}
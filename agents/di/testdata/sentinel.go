@@ -0,0 +1,6 @@
+package testdata
+
+import "errors"
+
+// ErrNotFound is a conventional sentinel error, not mutable shared state.
+var ErrNotFound = errors.New("not found")
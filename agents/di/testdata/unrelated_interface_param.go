@@ -0,0 +1,9 @@
+package testdata
+
+import "net/http"
+
+// Foo takes an interface parameter that has nothing to do with the
+// http.Get call it makes, so the call should still be flagged.
+func Foo(logger interface{ Log(string) }) {
+	http.Get("http://example.com")
+}
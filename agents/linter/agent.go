@@ -0,0 +1,112 @@
+// Package linter wraps golangci-lint as a deterministic, ground-truth
+// agent that runs alongside the module's LLM-driven agents. Its findings
+// use the same schema as every other agent, so they merge directly into a
+// combined report.
+package linter
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "linter"
+
+//go:embed golangci.yml
+var defaultConfigYAML []byte
+
+// Config controls how the linter agent invokes golangci-lint.
+type Config struct {
+	// BinaryPath is the golangci-lint executable to run. Defaults to
+	// "golangci-lint" on PATH.
+	BinaryPath string
+	// ConfigPath overrides the embedded default .golangci.yml with a
+	// caller-supplied one.
+	ConfigPath string
+	// Timeout bounds how long a single run may take. Defaults to 2
+	// minutes.
+	Timeout time.Duration
+}
+
+// Agent runs golangci-lint against a workspace and converts its JSON
+// output into findings.
+type Agent struct {
+	cfg Config
+}
+
+// New returns an Agent configured with cfg, filling in defaults for any
+// zero-valued fields.
+func New(cfg Config) *Agent {
+	if cfg.BinaryPath == "" {
+		cfg.BinaryPath = "golangci-lint"
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 2 * time.Minute
+	}
+	return &Agent{cfg: cfg}
+}
+
+// Run lints the Go packages under dir and returns one Finding per issue
+// golangci-lint reports.
+func (a *Agent) Run(ctx context.Context, dir string) ([]finding.Finding, error) {
+	configPath, cleanup, err := a.resolveConfig()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(ctx, a.cfg.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, a.cfg.BinaryPath, "run", "--out-format", "json", "--config", configPath, "./...")
+	cmd.Dir = dir
+
+	// golangci-lint exits non-zero whenever it reports at least one
+	// issue, so a non-nil error alongside JSON output is expected and
+	// not treated as failure.
+	out, runErr := cmd.Output()
+	if len(out) == 0 {
+		if runErr != nil {
+			return nil, fmt.Errorf("linter: run golangci-lint: %w", runErr)
+		}
+		return nil, nil
+	}
+
+	var result lintResult
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, fmt.Errorf("linter: parse golangci-lint output: %w", err)
+	}
+
+	findings := make([]finding.Finding, 0, len(result.Issues))
+	for _, iss := range result.Issues {
+		findings = append(findings, iss.toFinding())
+	}
+	return findings, nil
+}
+
+// resolveConfig returns the path to the .golangci.yml to use for this run,
+// writing the embedded default to a per-run temp workdir when the caller
+// hasn't supplied their own.
+func (a *Agent) resolveConfig() (path string, cleanup func(), err error) {
+	if a.cfg.ConfigPath != "" {
+		return a.cfg.ConfigPath, func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "linter-agent-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("linter: create temp workdir: %w", err)
+	}
+	path = filepath.Join(dir, ".golangci.yml")
+	if err := os.WriteFile(path, defaultConfigYAML, 0o644); err != nil {
+		os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("linter: write default config: %w", err)
+	}
+	return path, func() { os.RemoveAll(dir) }, nil
+}
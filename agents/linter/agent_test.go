@@ -0,0 +1,63 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveConfigWritesEmbeddedDefault(t *testing.T) {
+	a := New(Config{})
+
+	path, cleanup, err := a.resolveConfig()
+	if err != nil {
+		t.Fatalf("resolveConfig() error = %v", err)
+	}
+	defer cleanup()
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%s) error = %v", path, err)
+	}
+	if string(got) != string(defaultConfigYAML) {
+		t.Errorf("resolveConfig() wrote %q, want the embedded default config", got)
+	}
+
+	cleanup()
+	if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+		t.Errorf("cleanup() left %s behind, want it removed", filepath.Dir(path))
+	}
+}
+
+func TestResolveConfigPassesThroughOverride(t *testing.T) {
+	a := New(Config{ConfigPath: "/some/custom/.golangci.yml"})
+
+	path, cleanup, err := a.resolveConfig()
+	if err != nil {
+		t.Fatalf("resolveConfig() error = %v", err)
+	}
+	defer cleanup()
+
+	if path != "/some/custom/.golangci.yml" {
+		t.Errorf("resolveConfig() path = %q, want the configured override unchanged", path)
+	}
+}
+
+func TestIssueToFindingSeverityMapping(t *testing.T) {
+	tests := []struct {
+		severity string
+		want     string
+	}{
+		{"error", "critical"},
+		{"info", "info"},
+		{"warning", "major"},
+		{"", "major"},
+	}
+
+	for _, tt := range tests {
+		iss := issue{FromLinter: "gocyclo", Severity: tt.severity}
+		if got := string(iss.toFinding().Severity); got != tt.want {
+			t.Errorf("toFinding() with Severity %q = %q, want %q", tt.severity, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package linter
+
+import "github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+
+// lintResult mirrors the subset of golangci-lint's `--out-format json`
+// schema this agent consumes.
+type lintResult struct {
+	Issues []issue `json:"Issues"`
+}
+
+type issue struct {
+	FromLinter string        `json:"FromLinter"`
+	Text       string        `json:"Text"`
+	Severity   string        `json:"Severity"`
+	Pos        issuePosition `json:"Pos"`
+}
+
+type issuePosition struct {
+	Filename string `json:"Filename"`
+	Line     int    `json:"Line"`
+	Column   int    `json:"Column"`
+}
+
+// toFinding converts a raw golangci-lint issue into the module's common
+// Finding schema.
+func (i issue) toFinding() finding.Finding {
+	sev := finding.SeverityMajor
+	switch i.Severity {
+	case "error":
+		sev = finding.SeverityCritical
+	case "info":
+		sev = finding.SeverityInfo
+	}
+
+	return finding.Finding{
+		Agent:    agentName,
+		Rule:     i.FromLinter,
+		Severity: sev,
+		Message:  i.Text,
+		Position: finding.Position{
+			File:   i.Pos.Filename,
+			Line:   i.Pos.Line,
+			Column: i.Pos.Column,
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package linter
+
+import "github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+
+// category buckets rules from different agents that flag the same kind of
+// issue, so overlap can be detected even when the exact rule names don't
+// match.
+var category = map[string]string{
+	"gocyclo":        "complexity",
+	"max_cyclomatic": "complexity",
+	"max_cognitive":  "complexity",
+	"errcheck":       "error-handling",
+	"gosec":          "security",
+	"unused":         "unused",
+	"ineffassign":    "unused",
+	"deadcode":       "unused",
+	"revive":         "style",
+}
+
+// lineTolerance is how many lines apart two findings may be and still be
+// treated as the same underlying issue; agents don't always agree on
+// which line of a multi-line statement to report.
+const lineTolerance = 2
+
+// Reconcile returns a copy of llmFindings with Confidence set to
+// ConfidenceCorroborated for any finding that overlaps one of
+// linterFindings on the same file, a nearby line, and an equivalent
+// category, and ConfidenceLLMOnly otherwise.
+func Reconcile(llmFindings, linterFindings []finding.Finding) []finding.Finding {
+	reconciled := make([]finding.Finding, len(llmFindings))
+	for i, f := range llmFindings {
+		f.Confidence = finding.ConfidenceLLMOnly
+		for _, lf := range linterFindings {
+			if overlaps(f, lf) {
+				f.Confidence = finding.ConfidenceCorroborated
+				break
+			}
+		}
+		reconciled[i] = f
+	}
+	return reconciled
+}
+
+func overlaps(a, b finding.Finding) bool {
+	if a.Position.File != b.Position.File {
+		return false
+	}
+	if abs(a.Position.Line-b.Position.Line) > lineTolerance {
+		return false
+	}
+	cat := category[a.Rule]
+	return cat != "" && cat == category[b.Rule]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
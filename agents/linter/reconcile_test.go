@@ -0,0 +1,41 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+func TestReconcileMarksOverlappingFindingsCorroborated(t *testing.T) {
+	llmFindings := []finding.Finding{
+		{Rule: "max_cyclomatic", Position: finding.Position{File: "a.go", Line: 10}},
+		{Rule: "max_cyclomatic", Position: finding.Position{File: "a.go", Line: 200}},
+	}
+	linterFindings := []finding.Finding{
+		{Rule: "gocyclo", Position: finding.Position{File: "a.go", Line: 11}},
+	}
+
+	got := Reconcile(llmFindings, linterFindings)
+
+	if got[0].Confidence != finding.ConfidenceCorroborated {
+		t.Errorf("finding at line 10 = %s, want %s", got[0].Confidence, finding.ConfidenceCorroborated)
+	}
+	if got[1].Confidence != finding.ConfidenceLLMOnly {
+		t.Errorf("finding at line 200 = %s, want %s", got[1].Confidence, finding.ConfidenceLLMOnly)
+	}
+}
+
+func TestReconcileRequiresMatchingCategory(t *testing.T) {
+	llmFindings := []finding.Finding{
+		{Rule: "max_cyclomatic", Position: finding.Position{File: "a.go", Line: 10}},
+	}
+	linterFindings := []finding.Finding{
+		{Rule: "errcheck", Position: finding.Position{File: "a.go", Line: 10}},
+	}
+
+	got := Reconcile(llmFindings, linterFindings)
+
+	if got[0].Confidence != finding.ConfidenceLLMOnly {
+		t.Errorf("Confidence = %s, want %s", got[0].Confidence, finding.ConfidenceLLMOnly)
+	}
+}
@@ -0,0 +1,208 @@
+// Package logging finds error-carrying fmt.Print*/log.Print* calls and
+// proposes migrating them to structured logging (log/slog by default,
+// zap or zerolog as configurable alternatives), plus a rotation-aware
+// initializer for long-running main/server code.
+package logging
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "logging"
+
+// printCall describes one fmt/log call this agent recognizes.
+type printCall struct {
+	hasFormat bool
+}
+
+var printCalls = map[string]printCall{
+	"fmt.Print":   {hasFormat: false},
+	"fmt.Println": {hasFormat: false},
+	"fmt.Printf":  {hasFormat: true},
+	"log.Print":   {hasFormat: false},
+	"log.Println": {hasFormat: false},
+	"log.Printf":  {hasFormat: true},
+}
+
+// Agent analyzes Go source for unstructured error logging.
+type Agent struct {
+	cfg Config
+}
+
+// New returns an Agent that suggests migrations per cfg.
+func New(cfg Config) *Agent {
+	return &Agent{cfg: cfg}
+}
+
+// AnalyzeFile parses the Go source file at path and returns one Finding
+// per fmt.Print*/log.Print* call that carries error context, plus a
+// rotation-aware initializer suggestion if the file has a main function
+// and at least one such call was found.
+func (a *Agent) AnalyzeFile(path string) ([]finding.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("logging: parse %s: %w", path, err)
+	}
+
+	var findings []finding.Finding
+	hasMain := false
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		if fn.Name.Name == "main" && fn.Recv == nil {
+			hasMain = true
+		}
+		returnsError := funcReturnsError(fn)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name := selectorName(call.Fun)
+			pc, ok := printCalls[name]
+			if !ok {
+				return true
+			}
+			if !carriesErrorContext(call, pc, returnsError) {
+				return true
+			}
+			findings = append(findings, a.buildFinding(fset, fn, call, name, pc, returnsError, path))
+			return true
+		})
+	}
+
+	if hasMain && len(findings) > 0 {
+		findings = append(findings, finding.Finding{
+			Agent:      agentName,
+			Rule:       "logging-rotation",
+			Severity:   finding.SeverityInfo,
+			Message:    "main runs long enough to need a rotating log destination instead of the process's stdout",
+			Function:   "main",
+			Position:   finding.Position{File: path},
+			Suggestion: exampleInitializer(a.cfg),
+		})
+	}
+
+	return findings, nil
+}
+
+func (a *Agent) buildFinding(fset *token.FileSet, fn *ast.FuncDecl, call *ast.CallExpr, name string, pc printCall, returnsError bool, path string) finding.Finding {
+	pos := fset.Position(call.Pos())
+
+	var message string
+	var fields []field
+	if pc.hasFormat && len(call.Args) > 0 {
+		if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if format, err := strconv.Unquote(lit.Value); err == nil {
+				var verbCount int
+				message, verbCount = splitFormat(format)
+				valueArgs := call.Args[1:]
+				if verbCount < len(valueArgs) {
+					valueArgs = valueArgs[:verbCount]
+				}
+				fields = fieldsFromArgs(valueArgs)
+			}
+		}
+	} else {
+		message, fields = splitPrintArgs(call.Args)
+	}
+
+	level := "Warn"
+	if hasErrorArg(call.Args) || returnsError {
+		level = "Error"
+	}
+
+	return finding.Finding{
+		Agent:      agentName,
+		Rule:       "unstructured-error-logging",
+		Severity:   finding.SeverityMinor,
+		Message:    fmt.Sprintf("%s logs error context via %s instead of structured logging", fn.Name.Name, name),
+		Position:   finding.Position{File: path, Line: pos.Line, Column: pos.Column},
+		Function:   fn.Name.Name,
+		Suggestion: renderCall(a.cfg.target(), level, message, fields),
+	}
+}
+
+// splitPrintArgs separates a Print/Println call's string-literal
+// arguments (joined into a message) from its remaining expression
+// arguments (turned into fields).
+func splitPrintArgs(args []ast.Expr) (string, []field) {
+	var parts []string
+	var rest []ast.Expr
+	for _, arg := range args {
+		lit, ok := arg.(*ast.BasicLit)
+		if ok && lit.Kind == token.STRING {
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				parts = append(parts, s)
+				continue
+			}
+		}
+		rest = append(rest, arg)
+	}
+	message := strings.ToLower(strings.TrimRight(strings.Join(parts, " "), " :,-"))
+	return message, fieldsFromArgs(rest)
+}
+
+// carriesErrorContext reports whether call looks like it's reporting an
+// error: it formats with %v, references an "err"-named or "Error"-named
+// argument, or appears in a function that returns error.
+func carriesErrorContext(call *ast.CallExpr, pc printCall, returnsError bool) bool {
+	if pc.hasFormat && len(call.Args) > 0 {
+		if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.Contains(lit.Value, "%v") {
+			return true
+		}
+	}
+	return hasErrorArg(call.Args) || returnsError
+}
+
+func hasErrorArg(args []ast.Expr) bool {
+	for _, arg := range args {
+		found := false
+		ast.Inspect(arg, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && (id.Name == "err" || strings.Contains(id.Name, "Error")) {
+				found = true
+			}
+			return true
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func funcReturnsError(fn *ast.FuncDecl) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, field := range fn.Type.Results.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "error" {
+			return true
+		}
+	}
+	return false
+}
+
+func selectorName(expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return pkg.Name + "." + sel.Sel.Name
+}
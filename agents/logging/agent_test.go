@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeFileFlagsUnstructuredErrorLogging(t *testing.T) {
+	a := New(DefaultConfig())
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var gotFetch, gotRotation bool
+	for _, f := range findings {
+		if f.Rule == "unstructured-error-logging" && f.Function == "FetchUserFromAPI" {
+			gotFetch = true
+			if !strings.HasPrefix(f.Suggestion, "slog.Error(") {
+				t.Errorf("Suggestion = %q, want a slog.Error(...) call", f.Suggestion)
+			}
+			if !strings.Contains(f.Suggestion, `"err", err`) {
+				t.Errorf("Suggestion = %q, want an err field", f.Suggestion)
+			}
+		}
+		if f.Rule == "logging-rotation" {
+			gotRotation = true
+			if !strings.Contains(f.Suggestion, "lumberjack.Logger") {
+				t.Errorf("Suggestion = %q, want a lumberjack initializer", f.Suggestion)
+			}
+		}
+	}
+
+	if !gotFetch {
+		t.Errorf("expected an unstructured-error-logging finding for FetchUserFromAPI, got %+v", findings)
+	}
+	if !gotRotation {
+		t.Errorf("expected a logging-rotation finding since the file has a main(), got %+v", findings)
+	}
+}
+
+func TestAnalyzeFileWithZapTarget(t *testing.T) {
+	a := New(Config{Target: TargetZap})
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	for _, f := range findings {
+		if f.Rule == "unstructured-error-logging" && f.Function == "FetchUserFromAPI" {
+			if !strings.HasPrefix(f.Suggestion, "logger.Error(") {
+				t.Errorf("Suggestion = %q, want a zap logger.Error(...) call", f.Suggestion)
+			}
+			return
+		}
+	}
+	t.Fatalf("expected an unstructured-error-logging finding for FetchUserFromAPI")
+}
+
+func TestSplitFormatStripsVerbsAndCountsThem(t *testing.T) {
+	message, verbCount := splitFormat("Invalid email for user %d: %s")
+	if verbCount != 2 {
+		t.Errorf("verbCount = %d, want 2", verbCount)
+	}
+	if message == "" {
+		t.Errorf("message is empty")
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"ID":    "id",
+		"Name":  "name",
+		"Email": "email",
+	}
+	for in, want := range cases {
+		if got := toSnakeCase(in); got != want {
+			t.Errorf("toSnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package logging
+
+// Target is the structured logging library a migration suggestion should
+// be written against.
+type Target string
+
+const (
+	TargetSlog    Target = "slog"
+	TargetZap     Target = "zap"
+	TargetZerolog Target = "zerolog"
+)
+
+// RotationLibrary is the file-rotation package an example initializer
+// suggestion should use.
+type RotationLibrary string
+
+const (
+	RotationLumberjack     RotationLibrary = "lumberjack"
+	RotationFileRotatelogs RotationLibrary = "file-rotatelogs"
+)
+
+// Config controls which logging library and rotation library the agent's
+// suggestions are written against.
+type Config struct {
+	// Target is the structured logger to migrate to. Defaults to
+	// TargetSlog (log/slog).
+	Target Target
+	// Rotation is the rotation library suggested for main/server
+	// initializers. Defaults to RotationLumberjack.
+	Rotation RotationLibrary
+}
+
+// DefaultConfig targets log/slog with a lumberjack-based rotating writer,
+// matching this module's own preference for the standard library where
+// it's sufficient.
+func DefaultConfig() Config {
+	return Config{Target: TargetSlog, Rotation: RotationLumberjack}
+}
+
+func (c Config) target() Target {
+	if c.Target == "" {
+		return TargetSlog
+	}
+	return c.Target
+}
+
+func (c Config) rotation() RotationLibrary {
+	if c.Rotation == "" {
+		return RotationLumberjack
+	}
+	return c.Rotation
+}
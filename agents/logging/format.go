@@ -0,0 +1,123 @@
+package logging
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// verbPattern matches a single fmt verb, e.g. "%d", "%-8.2f", "%v".
+var verbPattern = regexp.MustCompile(`%[-+ #0]*[0-9]*(\.[0-9]+)?[vVTtbcdoqxXUeEfFgGsp]`)
+
+// field is one key/value pair extracted from a Printf-style call, derived
+// by matching a verb's position in the format string to the
+// corresponding argument expression.
+type field struct {
+	key   string
+	value string
+}
+
+// splitFormat separates a Printf-style format string into its literal
+// message (verbs removed, whitespace collapsed) and the number of verbs
+// found, so verbs can be zipped against the call's trailing arguments.
+func splitFormat(format string) (message string, verbCount int) {
+	escaped := strings.ReplaceAll(format, "%%", "\x00")
+	verbCount = len(verbPattern.FindAllString(escaped, -1))
+	literal := verbPattern.ReplaceAllString(escaped, "")
+	literal = strings.ReplaceAll(literal, "\x00", "%")
+	literal = strings.Join(strings.Fields(literal), " ")
+	literal = strings.TrimRight(literal, " :,-")
+	return strings.ToLower(strings.TrimSpace(literal)), verbCount
+}
+
+// fieldsFromArgs pairs each of args (in order) with a key derived from
+// its own expression, for use as structured logging key/value pairs.
+func fieldsFromArgs(args []ast.Expr) []field {
+	fields := make([]field, len(args))
+	for i, arg := range args {
+		fields[i] = field{key: keyFor(arg, i), value: exprString(arg)}
+	}
+	return fields
+}
+
+// keyFor derives a snake_case field name from an argument expression: the
+// identifier or selector name it ends in, or a positional fallback for
+// anything else (a literal, a call, etc).
+func keyFor(expr ast.Expr, index int) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return toSnakeCase(e.Name)
+	case *ast.SelectorExpr:
+		return toSnakeCase(e.Sel.Name)
+	default:
+		return fmt.Sprintf("value_%d", index+1)
+	}
+}
+
+// exprString renders an argument expression back to source text for
+// splicing into a suggested logging call. It only needs to handle the
+// simple identifier, selector, and call shapes these suggestions see in
+// practice.
+func exprString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.CallExpr:
+		args := make([]string, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = exprString(a)
+		}
+		return exprString(e.Fun) + "(" + strings.Join(args, ", ") + ")"
+	case *ast.BasicLit:
+		return e.Value
+	default:
+		return "..."
+	}
+}
+
+// matchFirstCap and matchAllCap split camel-cased identifiers into words
+// at case boundaries, without splitting runs of consecutive capitals
+// (acronyms like "ID").
+var (
+	matchFirstCap = regexp.MustCompile(`(.)([A-Z][a-z]+)`)
+	matchAllCap   = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// toSnakeCase converts a Go identifier like "userID" or "Email" into a
+// structured-logging key like "user_id" or "email".
+func toSnakeCase(name string) string {
+	s := matchFirstCap.ReplaceAllString(name, "${1}_${2}")
+	s = matchAllCap.ReplaceAllString(s, "${1}_${2}")
+	return strings.ToLower(s)
+}
+
+// renderCall builds the suggested structured-logging call for target,
+// given a severity level, a message, and the fields to attach.
+func renderCall(target Target, level, message string, fields []field) string {
+	switch target {
+	case TargetZap:
+		parts := make([]string, len(fields))
+		for i, f := range fields {
+			parts[i] = fmt.Sprintf("zap.Any(%q, %s)", f.key, f.value)
+		}
+		return fmt.Sprintf("logger.%s(%q, %s)", level, message, strings.Join(parts, ", "))
+	case TargetZerolog:
+		var b strings.Builder
+		fmt.Fprintf(&b, "log.%s()", level)
+		for _, f := range fields {
+			fmt.Fprintf(&b, ".Interface(%q, %s)", f.key, f.value)
+		}
+		fmt.Fprintf(&b, ".Msg(%q)", message)
+		return b.String()
+	default: // TargetSlog
+		parts := make([]string, 0, len(fields)*2)
+		for _, f := range fields {
+			parts = append(parts, fmt.Sprintf("%q", f.key), f.value)
+		}
+		args := append([]string{fmt.Sprintf("%q", message)}, parts...)
+		return fmt.Sprintf("slog.%s(%s)", level, strings.Join(args, ", "))
+	}
+}
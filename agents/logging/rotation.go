@@ -0,0 +1,46 @@
+package logging
+
+// rotationWriterSnippet returns an example io.Writer initializer for the
+// configured rotation library.
+func rotationWriterSnippet(lib RotationLibrary) string {
+	switch lib {
+	case RotationFileRotatelogs:
+		return `writer, err := rotatelogs.New(
+	"app.%Y%m%d.log",
+	rotatelogs.WithMaxAge(28*24*time.Hour),
+	rotatelogs.WithRotationTime(24*time.Hour),
+)
+if err != nil {
+	panic(err)
+}`
+	default: // RotationLumberjack
+		return `writer := &lumberjack.Logger{
+	Filename:   "app.log",
+	MaxSize:    100, // megabytes
+	MaxBackups: 3,
+	MaxAge:     28, // days
+}`
+	}
+}
+
+// loggerInitSnippet returns an example logger construction on top of a
+// writer produced by rotationWriterSnippet, for the configured target.
+func loggerInitSnippet(target Target) string {
+	switch target {
+	case TargetZap:
+		return `core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(writer), zap.InfoLevel)
+logger := zap.New(core)`
+	case TargetZerolog:
+		return `log.Logger = zerolog.New(writer).With().Timestamp().Logger()`
+	default: // TargetSlog
+		return `logger := slog.New(slog.NewJSONHandler(writer, nil))
+slog.SetDefault(logger)`
+	}
+}
+
+// exampleInitializer renders a full rotation-aware logger setup for
+// main/server code, combining a rotating writer with the configured
+// structured logger.
+func exampleInitializer(cfg Config) string {
+	return rotationWriterSnippet(cfg.rotation()) + "\n" + loggerInitSnippet(cfg.target())
+}
@@ -0,0 +1,82 @@
+// Package styleguide runs a composable set of AST-matcher rules, grouped
+// into named packs (thanos, uber-go, google-go, effective-go), against Go
+// source files. It covers the conventions gofmt and go vet don't enforce:
+// receiver naming, doc comments, defer placement, and the like.
+package styleguide
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "styleguide"
+
+// resolvedRule pairs a pack's rule metadata with its Checker
+// implementation.
+type resolvedRule struct {
+	RuleMeta
+	pack    string
+	checker Checker
+}
+
+// Agent runs a composed set of rule packs' checkers against Go source.
+type Agent struct {
+	rules []resolvedRule
+}
+
+// New composes an Agent out of the named built-in packs. A rule ID that
+// appears in more than one requested pack runs once, keeping the
+// metadata from the first pack that defines it.
+func New(packNames ...string) (*Agent, error) {
+	seen := map[string]bool{}
+	var rules []resolvedRule
+
+	for _, name := range packNames {
+		def, err := LoadPack(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, rm := range def.Rules {
+			if seen[rm.ID] {
+				continue
+			}
+			checker, ok := checkers[rm.ID]
+			if !ok {
+				return nil, fmt.Errorf("styleguide: pack %q references unknown rule %q", name, rm.ID)
+			}
+			seen[rm.ID] = true
+			rules = append(rules, resolvedRule{RuleMeta: rm, pack: name, checker: checker})
+		}
+	}
+	return &Agent{rules: rules}, nil
+}
+
+// AnalyzeFile parses the Go source file at path and returns a Finding for
+// every match produced by this Agent's resolved rules.
+func (a *Agent) AnalyzeFile(path string) ([]finding.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("styleguide: parse %s: %w", path, err)
+	}
+
+	var findings []finding.Finding
+	for _, rule := range a.rules {
+		for _, m := range rule.checker(fset, file) {
+			pos := fset.Position(m.pos)
+			findings = append(findings, finding.Finding{
+				Agent:      agentName,
+				Rule:       rule.pack + "." + rule.ID,
+				Severity:   rule.Severity,
+				Message:    m.detail,
+				Position:   finding.Position{File: path, Line: pos.Line, Column: pos.Column},
+				Function:   m.function,
+				Suggestion: rule.Rationale,
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,61 @@
+package styleguide
+
+import "testing"
+
+func TestAnalyzeFileWithThanosPackFindsViolations(t *testing.T) {
+	a, err := New("thanos")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	findings, err := a.AnalyzeFile("testdata/violations.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	want := map[string]bool{
+		"thanos.receiver-name-consistency":    false,
+		"thanos.receiver-pointer-consistency": false,
+		"thanos.exported-doc-comment":         false,
+		"thanos.package-doc-comment":          false,
+		"thanos.defer-after-acquisition":      false,
+		"thanos.time-now-injection":           false,
+		"thanos.interface-any-modernization":  false,
+		"thanos.errors-wrap-context":          false,
+		"thanos.no-unkeyed-struct-literal":    false,
+		"thanos.named-returns-sparingly":      false,
+	}
+	for _, f := range findings {
+		if _, ok := want[f.Rule]; ok {
+			want[f.Rule] = true
+		}
+	}
+	for rule, got := range want {
+		if !got {
+			t.Errorf("expected a %s finding, got none", rule)
+		}
+	}
+}
+
+func TestNewRejectsUnknownPack(t *testing.T) {
+	if _, err := New("not-a-real-pack"); err == nil {
+		t.Fatal("New(\"not-a-real-pack\") error = nil, want an error")
+	}
+}
+
+func TestNewDedupesRulesAcrossComposedPacks(t *testing.T) {
+	a, err := New("thanos", "google-go")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	count := 0
+	for _, r := range a.rules {
+		if r.ID == "exported-doc-comment" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("exported-doc-comment resolved %d times, want 1", count)
+	}
+}
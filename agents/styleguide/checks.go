@@ -0,0 +1,395 @@
+package styleguide
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// checkers maps a rule ID to the function that detects it. Rule packs
+// reference these IDs; see packs/*.yaml for which pack enables which
+// rule, at what severity, and why.
+var checkers = map[string]Checker{
+	"receiver-name-consistency":    checkReceiverNameConsistency,
+	"receiver-pointer-consistency": checkReceiverPointerConsistency,
+	"exported-doc-comment":         checkExportedDocComment,
+	"package-doc-comment":          checkPackageDocComment,
+	"defer-after-acquisition":      checkDeferAfterAcquisition,
+	"time-now-injection":           checkTimeNowInjection,
+	"interface-any-modernization":  checkInterfaceAnyModernization,
+	"errors-wrap-context":          checkErrorsWrapContext,
+	"no-unkeyed-struct-literal":    checkNoUnkeyedStructLiteral,
+	"named-returns-sparingly":      checkNamedReturnsSparingly,
+}
+
+// checkReceiverNameConsistency flags methods whose receiver name differs
+// from the name used by the first method seen for that type.
+func checkReceiverNameConsistency(fset *token.FileSet, file *ast.File) []match {
+	firstName := map[string]string{}
+	var matches []match
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+			continue
+		}
+		recv := fn.Recv.List[0]
+		if len(recv.Names) == 0 {
+			continue
+		}
+		typeName := receiverTypeName(recv.Type)
+		name := recv.Names[0].Name
+		if want, ok := firstName[typeName]; ok {
+			if want != name {
+				matches = append(matches, match{
+					pos:      recv.Pos(),
+					function: fn.Name.Name,
+					detail:   fmt.Sprintf("receiver %q on %s should be named %q, matching its other methods", name, typeName, want),
+				})
+			}
+			continue
+		}
+		firstName[typeName] = name
+	}
+	return matches
+}
+
+// checkReceiverPointerConsistency flags a method whose receiver kind
+// (pointer vs. value) differs from the first method seen for that type.
+func checkReceiverPointerConsistency(fset *token.FileSet, file *ast.File) []match {
+	firstPtr := map[string]bool{}
+	seen := map[string]bool{}
+	var matches []match
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+			continue
+		}
+		recv := fn.Recv.List[0]
+		typeName := receiverTypeName(recv.Type)
+		_, isPtr := recv.Type.(*ast.StarExpr)
+
+		if seen[typeName] {
+			if firstPtr[typeName] != isPtr {
+				matches = append(matches, match{
+					pos:      recv.Pos(),
+					function: fn.Name.Name,
+					detail:   fmt.Sprintf("%s mixes pointer and value receivers on %s", fn.Name.Name, typeName),
+				})
+			}
+			continue
+		}
+		seen[typeName] = true
+		firstPtr[typeName] = isPtr
+	}
+	return matches
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// checkExportedDocComment flags exported functions and types declared
+// without a doc comment.
+func checkExportedDocComment(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil || !ast.IsExported(d.Name.Name) {
+				continue
+			}
+			if d.Doc == nil {
+				matches = append(matches, match{pos: d.Pos(), function: d.Name.Name, detail: fmt.Sprintf("exported function %s has no doc comment", d.Name.Name)})
+			}
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || !ast.IsExported(ts.Name.Name) {
+					continue
+				}
+				if ts.Doc == nil && d.Doc == nil {
+					matches = append(matches, match{pos: ts.Pos(), function: ts.Name.Name, detail: fmt.Sprintf("exported type %s has no doc comment", ts.Name.Name)})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// checkPackageDocComment flags a file whose package clause has no
+// preceding doc comment.
+func checkPackageDocComment(fset *token.FileSet, file *ast.File) []match {
+	if file.Doc != nil {
+		return nil
+	}
+	return []match{{pos: file.Package, detail: fmt.Sprintf("package %s has no package-level doc comment", file.Name.Name)}}
+}
+
+// resourceAcquisition maps a call's method name to the defer call that
+// should follow it directly.
+var resourceAcquisition = map[string]string{
+	"Lock":  "Unlock",
+	"RLock": "RUnlock",
+}
+
+// checkDeferAfterAcquisition flags a lock/open-style acquisition that
+// isn't followed within the next couple of statements by the matching
+// defer, e.g. `mu.Lock()` without a nearby `defer mu.Unlock()`.
+func checkDeferAfterAcquisition(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	ast.Inspect(file, func(n ast.Node) bool {
+		block, ok := n.(*ast.BlockStmt)
+		if !ok {
+			return true
+		}
+		for i, stmt := range block.List {
+			recvName, closeMethod, ok := acquisitionCall(stmt)
+			if !ok {
+				continue
+			}
+			lookahead := block.List[i+1:]
+			if len(lookahead) > 2 {
+				lookahead = lookahead[:2]
+			}
+			if !hasMatchingDefer(lookahead, recvName, closeMethod) {
+				matches = append(matches, match{
+					pos:    stmt.Pos(),
+					detail: fmt.Sprintf("%s.%s() should be followed immediately by `defer %s.%s()`", recvName, invertMethod(closeMethod), recvName, closeMethod),
+				})
+			}
+		}
+		return true
+	})
+	return matches
+}
+
+func acquisitionCall(stmt ast.Stmt) (recv, closeMethod string, ok bool) {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return "", "", false
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", "", false
+		}
+		closeMethod, ok = resourceAcquisition[sel.Sel.Name]
+		if !ok {
+			return "", "", false
+		}
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return "", "", false
+		}
+		return ident.Name, closeMethod, true
+	case *ast.AssignStmt:
+		if len(s.Lhs) == 0 || len(s.Rhs) != 1 {
+			return "", "", false
+		}
+		call, ok := s.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return "", "", false
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Open" {
+			return "", "", false
+		}
+		ident, ok := s.Lhs[0].(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			return "", "", false
+		}
+		return ident.Name, "Close", true
+	default:
+		return "", "", false
+	}
+}
+
+func invertMethod(closeMethod string) string {
+	for open, closeM := range resourceAcquisition {
+		if closeM == closeMethod {
+			return open
+		}
+	}
+	return "Open"
+}
+
+func hasMatchingDefer(stmts []ast.Stmt, recv, method string) bool {
+	for _, stmt := range stmts {
+		deferStmt, ok := stmt.(*ast.DeferStmt)
+		if !ok {
+			continue
+		}
+		sel, ok := deferStmt.Call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != method {
+			continue
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == recv {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTimeNowInjection flags direct calls to time.Now, which the Thanos
+// guide prefers to see injected as a `func() time.Time` for testability.
+func checkTimeNowInjection(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Now" {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "time" {
+				matches = append(matches, match{
+					pos:      call.Pos(),
+					function: fn.Name.Name,
+					detail:   fmt.Sprintf("%s calls time.Now() directly; inject a func() time.Time clock instead", fn.Name.Name),
+				})
+			}
+			return true
+		})
+	}
+	return matches
+}
+
+// checkInterfaceAnyModernization flags `interface{}` used as a type,
+// which can be written as the `any` alias since Go 1.18.
+func checkInterfaceAnyModernization(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	ast.Inspect(file, func(n ast.Node) bool {
+		it, ok := n.(*ast.InterfaceType)
+		if !ok || it.Methods == nil || len(it.Methods.List) != 0 {
+			return true
+		}
+		matches = append(matches, match{pos: it.Pos(), detail: "interface{} can be written as any"})
+		return true
+	})
+	return matches
+}
+
+// checkErrorsWrapContext flags errors.New calls made inside a function
+// that itself returns error and takes parameters, where wrapping the
+// caller's context (e.g. with fmt.Errorf("...: %w", err)) would usually
+// be more useful than a bare sentinel-style message.
+func checkErrorsWrapContext(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !funcReturnsError(fn) || !hasParams(fn) {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "New" {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "errors" {
+				matches = append(matches, match{
+					pos:      call.Pos(),
+					function: fn.Name.Name,
+					detail:   fmt.Sprintf("%s constructs a bare error with errors.New; wrap it with fmt.Errorf(\"...: %%w\", err) to keep the caller's context", fn.Name.Name),
+				})
+			}
+			return true
+		})
+	}
+	return matches
+}
+
+func funcReturnsError(fn *ast.FuncDecl) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, field := range fn.Type.Results.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "error" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasParams(fn *ast.FuncDecl) bool {
+	return fn.Type.Params != nil && len(fn.Type.Params.List) > 0
+}
+
+// checkNoUnkeyedStructLiteral flags composite literals for a named type
+// whose fields are set positionally instead of by key.
+func checkNoUnkeyedStructLiteral(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || len(lit.Elts) == 0 {
+			return true
+		}
+		if _, keyed := lit.Elts[0].(*ast.KeyValueExpr); keyed {
+			return true
+		}
+		name, ok := namedTypeExprName(lit.Type)
+		if !ok {
+			return true
+		}
+		matches = append(matches, match{pos: lit.Pos(), detail: fmt.Sprintf("%s{...} uses unkeyed fields; use field names so reordering the struct can't silently break callers", name)})
+		return true
+	})
+	return matches
+}
+
+func namedTypeExprName(expr ast.Expr) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name, true
+	case *ast.SelectorExpr:
+		return t.Sel.Name, true
+	default:
+		return "", false
+	}
+}
+
+// checkNamedReturnsSparingly flags functions with more than two named
+// return values, per the Thanos guide's "use named returns sparingly".
+func checkNamedReturnsSparingly(fset *token.FileSet, file *ast.File) []match {
+	var matches []match
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Type.Results == nil {
+			continue
+		}
+		count := 0
+		for _, field := range fn.Type.Results.List {
+			count += len(field.Names)
+		}
+		if count > 2 {
+			matches = append(matches, match{
+				pos:      fn.Pos(),
+				function: fn.Name.Name,
+				detail:   fmt.Sprintf("%s has %d named return values; use named returns sparingly", fn.Name.Name, count),
+			})
+		}
+	}
+	return matches
+}
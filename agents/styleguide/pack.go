@@ -0,0 +1,57 @@
+package styleguide
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+//go:embed packs/thanos.yaml
+var thanosYAML []byte
+
+//go:embed packs/uber-go.yaml
+var uberGoYAML []byte
+
+//go:embed packs/google-go.yaml
+var googleGoYAML []byte
+
+//go:embed packs/effective-go.yaml
+var effectiveGoYAML []byte
+
+var builtinPacks = map[string][]byte{
+	"thanos":       thanosYAML,
+	"uber-go":      uberGoYAML,
+	"google-go":    googleGoYAML,
+	"effective-go": effectiveGoYAML,
+}
+
+// RuleMeta identifies one rule within a pack: which Checker to run (by
+// ID, looked up in the checkers registry), at what severity, and why.
+type RuleMeta struct {
+	ID        string           `yaml:"id"`
+	Severity  finding.Severity `yaml:"severity"`
+	Rationale string           `yaml:"rationale"`
+}
+
+// PackDef is a named, YAML-declared collection of rules.
+type PackDef struct {
+	Name  string     `yaml:"name"`
+	Rules []RuleMeta `yaml:"rules"`
+}
+
+// LoadPack parses one of the built-in rule packs by name: "thanos",
+// "uber-go", "google-go", or "effective-go".
+func LoadPack(name string) (PackDef, error) {
+	data, ok := builtinPacks[name]
+	if !ok {
+		return PackDef{}, fmt.Errorf("styleguide: unknown rule pack %q", name)
+	}
+	var def PackDef
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return PackDef{}, fmt.Errorf("styleguide: parse pack %q: %w", name, err)
+	}
+	return def, nil
+}
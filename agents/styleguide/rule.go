@@ -0,0 +1,17 @@
+package styleguide
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// match is one occurrence of a rule violation found by a Checker.
+type match struct {
+	pos      token.Pos
+	function string
+	detail   string
+}
+
+// Checker inspects a parsed file and returns every place it finds a rule
+// violated.
+type Checker func(fset *token.FileSet, file *ast.File) []match
@@ -0,0 +1,49 @@
+package testdata
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+type Widget struct {
+	Name string
+	Size int
+}
+
+func (w Widget) Area() int { return w.Size * w.Size }
+
+func (widget *Widget) Scale(factor int) { widget.Size *= factor }
+
+func NoDocComment(id int) error {
+	return errors.New("not found")
+}
+
+func TooManyNamedReturns() (a, b, c int) {
+	return 1, 2, 3
+}
+
+func buildWidget() Widget {
+	return Widget{"gadget", 4}
+}
+
+type row struct {
+	id   int
+	name string
+}
+
+func buildRow() row {
+	return row{1, "first"}
+}
+
+func acceptsAny(v interface{}) {
+	_ = v
+}
+
+func lockWithoutImmediateDefer(mu *sync.Mutex) {
+	mu.Lock()
+	_ = time.Now()
+	_ = time.Now()
+	_ = time.Now()
+	defer mu.Unlock()
+}
@@ -0,0 +1,233 @@
+// Package validation detects field-level validation logic that is
+// duplicated across functions operating on the same struct type, and
+// proposes consolidating it into a `Validate() error` method on that
+// type.
+package validation
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/rahulgupta2018/ai-code-review-multi-agent/pkg/finding"
+)
+
+const agentName = "validation"
+
+// Agent finds duplicated validation ladders.
+type Agent struct{}
+
+// New returns an Agent.
+func New() *Agent {
+	return &Agent{}
+}
+
+// occurrence is one check found while walking a function, tied back to
+// the function it was found in.
+type occurrence struct {
+	funcName  string
+	canonical string
+	cond      ast.Expr
+}
+
+// AnalyzeFile parses the Go source file at path, resolves types with
+// go/types, and returns one Finding per struct type whose field checks are
+// duplicated across two or more functions.
+func (a *Agent) AnalyzeFile(path string) ([]finding.Finding, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.AllErrors)
+	if err != nil {
+		return nil, fmt.Errorf("validation: parse %s: %w", path, err)
+	}
+
+	info := &types.Info{
+		Types: map[ast.Expr]types.TypeAndValue{},
+		Defs:  map[*ast.Ident]types.Object{},
+		Uses:  map[*ast.Ident]types.Object{},
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {}, // best-effort: keep partial type info even on unrelated errors
+	}
+	_, _ = conf.Check(file.Name.Name, fset, []*ast.File{file}, info)
+
+	// typeName -> canonical hash -> occurrences of that check
+	byType := map[string]map[uint64][]occurrence{}
+	// typeName -> where the type is declared, for the suggestion's position
+	typePos := map[string]finding.Position{}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			ifStmt, ok := n.(*ast.IfStmt)
+			if !ok {
+				return true
+			}
+			sel := firstStructFieldSelector(ifStmt.Cond, info)
+			if sel == nil {
+				return true
+			}
+			subject, ok := baseIdentName(sel.X)
+			if !ok {
+				return true
+			}
+			typeName, defPos, ok := resolveStructType(info, fset, sel.X)
+			if !ok {
+				return true
+			}
+			if _, seen := typePos[typeName]; !seen {
+				typePos[typeName] = defPos
+			}
+			if byType[typeName] == nil {
+				byType[typeName] = map[uint64][]occurrence{}
+			}
+			canon := canonicalize(ifStmt.Cond, subject)
+			key := hashOf(canon)
+			byType[typeName][key] = append(byType[typeName][key], occurrence{
+				funcName:  fn.Name.Name,
+				canonical: canon,
+				cond:      ifStmt.Cond,
+			})
+			return true
+		})
+	}
+
+	var findings []finding.Finding
+	for typeName, clusters := range byType {
+		funcsWithDuplication := map[string]bool{}
+		var invariants []string
+		for _, occs := range clusters {
+			invariants = append(invariants, occs[0].canonical)
+			funcs := map[string]bool{}
+			for _, o := range occs {
+				funcs[o.funcName] = true
+			}
+			if len(funcs) > 1 {
+				for f := range funcs {
+					funcsWithDuplication[f] = true
+				}
+			}
+		}
+		if len(funcsWithDuplication) == 0 {
+			continue
+		}
+
+		callSites := make([]string, 0, len(funcsWithDuplication))
+		for f := range funcsWithDuplication {
+			callSites = append(callSites, f)
+		}
+		sort.Strings(callSites)
+		sort.Strings(invariants)
+
+		findings = append(findings, finding.Finding{
+			Agent:      agentName,
+			Rule:       "duplicated-validation-ladder",
+			Severity:   finding.SeverityMajor,
+			Message:    fmt.Sprintf("%s field validation is duplicated across %s", typeName, strings.Join(callSites, ", ")),
+			Position:   typePos[typeName],
+			Suggestion: buildSuggestion(typeName, invariants, callSites),
+		})
+	}
+	return findings, nil
+}
+
+// firstStructFieldSelector returns the first SelectorExpr in cond whose
+// base expression (e.g. "users[i]" in "users[i].Age") resolves, via
+// go/types, to a named struct type. The receiver or parameter it comes
+// from doesn't matter; only the type being validated does.
+func firstStructFieldSelector(cond ast.Expr, info *types.Info) *ast.SelectorExpr {
+	var found *ast.SelectorExpr
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if _, isStruct := namedStructType(info, sel.X); isStruct {
+			found = sel
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// baseIdentName returns the root identifier of an expression built from
+// indexing, dereferencing, or selecting, e.g. "users" for "users[i]".
+func baseIdentName(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name, true
+	case *ast.IndexExpr:
+		return baseIdentName(e.X)
+	case *ast.StarExpr:
+		return baseIdentName(e.X)
+	case *ast.SelectorExpr:
+		return baseIdentName(e.X)
+	case *ast.ParenExpr:
+		return baseIdentName(e.X)
+	default:
+		return "", false
+	}
+}
+
+// namedStructType uses go/types to resolve the named struct type behind
+// expr, if any, looking through a leading pointer indirection.
+func namedStructType(info *types.Info, expr ast.Expr) (*types.Named, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Type == nil {
+		return nil, false
+	}
+	t := tv.Type
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+	return named, true
+}
+
+// resolveStructType resolves the named struct type behind expr, returning
+// its name and the position of its declaration.
+func resolveStructType(info *types.Info, fset *token.FileSet, expr ast.Expr) (string, finding.Position, bool) {
+	named, ok := namedStructType(info, expr)
+	if !ok {
+		return "", finding.Position{}, false
+	}
+	pos := fset.Position(named.Obj().Pos())
+	return named.Obj().Name(), finding.Position{File: pos.Filename, Line: pos.Line, Column: pos.Column}, true
+}
+
+// buildSuggestion renders a Validate() error method skeleton covering
+// every detected invariant, plus the list of functions whose validation
+// ladders it should replace.
+func buildSuggestion(typeName string, invariants, callSites []string) string {
+	receiver := strings.ToLower(typeName[:1])
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "func (%s %s) Validate() error {\n", receiver, typeName)
+	for _, invariant := range invariants {
+		expr := strings.ReplaceAll(invariant, "recv[i]", receiver)
+		expr = strings.ReplaceAll(expr, "recv", receiver)
+		fmt.Fprintf(&b, "\tif !(%s) {\n\t\treturn fmt.Errorf(\"invalid %s: %s\")\n\t}\n", expr, typeName, expr)
+	}
+	b.WriteString("\treturn nil\n}")
+
+	fmt.Fprintf(&b, "\n\nReplace the validation ladders in: %s", strings.Join(callSites, ", "))
+	return b.String()
+}
@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeFileFindsDuplicatedValidationLadder(t *testing.T) {
+	a := New()
+	findings, err := a.AnalyzeFile("../../tests/input_files/user_service.go")
+	if err != nil {
+		t.Fatalf("AnalyzeFile() error = %v", err)
+	}
+
+	var got bool
+	for _, f := range findings {
+		if f.Rule != "duplicated-validation-ladder" {
+			continue
+		}
+		if !strings.Contains(f.Message, "User") {
+			continue
+		}
+		got = true
+		if !strings.Contains(f.Suggestion, "func (u User) Validate() error") {
+			t.Errorf("Suggestion = %q, want a Validate() skeleton for User", f.Suggestion)
+		}
+		if !strings.Contains(f.Suggestion, "ProcessUsers") || !strings.Contains(f.Suggestion, "ValidateUsers") {
+			t.Errorf("Suggestion = %q, want call sites ProcessUsers and ValidateUsers", f.Suggestion)
+		}
+	}
+	if !got {
+		t.Fatalf("expected a duplicated-validation-ladder finding for User, got %+v", findings)
+	}
+}
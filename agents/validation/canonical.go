@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"fmt"
+	"go/ast"
+	"hash/fnv"
+	"unicode"
+)
+
+// canonicalize renders expr as a string with subject identifiers replaced
+// by "recv" and index expressions replaced by a generic "[i]", so that
+// structurally identical checks written against different variable or
+// loop-index names hash to the same value. Comparisons with a literal
+// operand are normalized to put the literal on the right, flipping the
+// operator accordingly, so `0 < x.Age` and `x.Age > 0` canonicalize the
+// same way.
+func canonicalize(expr ast.Expr, subject string) string {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return canonicalize(e.X, subject)
+	case *ast.BinaryExpr:
+		left := canonicalize(e.X, subject)
+		right := canonicalize(e.Y, subject)
+		op := e.Op.String()
+		if isLiteral(left) && !isLiteral(right) {
+			left, right, op = right, left, flip(op)
+		}
+		return left + " " + op + " " + right
+	case *ast.CallExpr:
+		args := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			args[i] = canonicalize(arg, subject)
+		}
+		return canonicalize(e.Fun, subject) + "(" + joinComma(args) + ")"
+	case *ast.SelectorExpr:
+		return canonicalize(e.X, subject) + "." + e.Sel.Name
+	case *ast.IndexExpr:
+		return canonicalize(e.X, subject) + "[i]"
+	case *ast.UnaryExpr:
+		return e.Op.String() + canonicalize(e.X, subject)
+	case *ast.Ident:
+		if e.Name == subject {
+			return "recv"
+		}
+		return e.Name
+	case *ast.BasicLit:
+		return e.Value
+	default:
+		return fmt.Sprintf("<%T>", expr)
+	}
+}
+
+func joinComma(parts []string) string {
+	out := ""
+	for i, p := range parts {
+		if i > 0 {
+			out += ", "
+		}
+		out += p
+	}
+	return out
+}
+
+func isLiteral(s string) bool {
+	if s == "" {
+		return false
+	}
+	r := rune(s[0])
+	return unicode.IsDigit(r) || r == '"' || r == '\''
+}
+
+func flip(op string) string {
+	switch op {
+	case ">":
+		return "<"
+	case "<":
+		return ">"
+	case ">=":
+		return "<="
+	case "<=":
+		return ">="
+	default:
+		return op
+	}
+}
+
+// hashOf returns a stable hash for a canonical check string, used as the
+// cluster key when grouping semantically equivalent checks.
+func hashOf(canonical string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(canonical))
+	return h.Sum64()
+}
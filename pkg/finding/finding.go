@@ -0,0 +1,67 @@
+// Package finding defines the common result schema shared by every review
+// agent in this module. Each agent (AST-based, LLM-based, or a wrapper
+// around an external tool) converts whatever it discovers into a slice of
+// Finding values, so a downstream reporter can merge, rank, and render
+// results from unrelated agents without knowing how any of them work.
+package finding
+
+// Severity classifies how urgently a Finding should be addressed.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityMinor    Severity = "minor"
+	SeverityMajor    Severity = "major"
+	SeverityCritical Severity = "critical"
+)
+
+// Position locates a Finding within a source file. Line and Column are
+// 1-based, matching token.FileSet.Position.
+type Position struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Metric captures a measured value against a configured threshold, for
+// findings produced by metric-based agents such as the complexity agent.
+type Metric struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+	Limit int    `json:"limit"`
+}
+
+// Confidence records whether a finding from a non-deterministic agent
+// (e.g. an LLM) has been corroborated by a deterministic one (e.g. a
+// linter), so a report can weight findings accordingly.
+type Confidence string
+
+const (
+	// ConfidenceCorroborated means a deterministic agent flagged the same
+	// issue independently.
+	ConfidenceCorroborated Confidence = "corroborated"
+	// ConfidenceLLMOnly means only a non-deterministic agent flagged the
+	// issue.
+	ConfidenceLLMOnly Confidence = "llm_only"
+)
+
+// Finding is a single issue reported by an agent.
+type Finding struct {
+	// Agent identifies which agent produced the finding, e.g. "complexity".
+	Agent string `json:"agent"`
+	// Rule identifies the specific check within the agent, e.g. "max_returns".
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Position Position `json:"position"`
+	// Function is the enclosing function name, when applicable.
+	Function string `json:"function,omitempty"`
+	// Metric is set for threshold-based findings; nil otherwise.
+	Metric *Metric `json:"metric,omitempty"`
+	// Suggestion is a human-readable refactor suggestion, when the agent
+	// has one.
+	Suggestion string `json:"suggestion,omitempty"`
+	// Confidence is set by a reconciliation pass that cross-checks
+	// findings between agents; it is empty until then.
+	Confidence Confidence `json:"confidence,omitempty"`
+}
@@ -1,3 +1,9 @@
+//go:build ignore
+
+// This file is a fixture of deliberately poor-quality Go code, used as
+// input by the agents/* analyzers' tests. It is excluded from normal
+// builds because it contains the unused imports and dead code the
+// analyzers are meant to flag.
 package main
 
 import (